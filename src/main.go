@@ -1,39 +1,373 @@
 package main
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
 	"fmt"
+	"net/http"
+	"net/url"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/fsnotify/fsnotify"
+	"gopkg.in/yaml.v3"
 
 	gt "gopkg.gilang.dev/google-translate"
 	"gopkg.gilang.dev/google-translate/params"
 )
 
-// Translation cache to avoid repeated API calls
+// How long to wait after the last filesystem event before refreshing the
+// listing, so a burst of events (e.g. `cp -r`) only triggers one reload.
+const watchDebounceDelay = 200 * time.Millisecond
+
+// Source/target language pair in use this run, set from the config file and
+// overridable with --from/--to.
+var (
+	fromLang = "en"
+	toLang   = "fr"
+)
+
+// locale holds the UI strings that depend on the target language, as
+// opposed to staticTranslations which is just translation data.
+type locale struct {
+	File        string
+	Folder      string
+	Renaming    string
+	Translating string
+
+	Title           string
+	NamePlaceholder string
+	Progress        string
+	RenameSuccess   string
+	ErrorStatus     string
+	BulkSuccess     string
+	BulkPartial     string
+	UndoSuccess     string
+	UndoNone        string
+	NoTranslation   string
+	NoSelection     string
+	Quit            string
+	FatalError      string
+
+	ConfirmTitle string
+	ConfirmOld   string
+	ConfirmNew   string
+	ConfirmEdit  string
+	ConfirmKeys  string
+
+	GitWarnTitle  string
+	GitWarnPrompt string
+	YesNoKeys     string
+
+	BulkPreview     string
+	BulkPreviewKeys string
+	BulkHelpKeys    string
+
+	HelpParent string
+	HelpOpen   string
+	HelpRename string
+	HelpBulk   string
+	HelpUndo   string
+}
+
+// locales is a bundle of UI strings per target language. Languages not
+// listed here fall back to English.
+var locales = map[string]locale{
+	"fr": {
+		File:        "Fichier",
+		Folder:      "Dossier",
+		Renaming:    "Renommage en cours...",
+		Translating: "Traduction en cours...",
+
+		Title:           "Navigateur de fichiers",
+		NamePlaceholder: "Nouveau nom...",
+		Progress:        "Traduction %d/%d…",
+		RenameSuccess:   "✓ Renommé: %s → %s",
+		ErrorStatus:     "✗ Erreur: %v",
+		BulkSuccess:     "✓ %d élément(s) renommé(s)",
+		BulkPartial:     "✓ %d renommé(s), ✗ %d en erreur",
+		UndoSuccess:     "↺ %d renommage(s) annulé(s)",
+		UndoNone:        "aucun renommage à annuler",
+		NoTranslation:   "✗ Pas de traduction disponible",
+		NoSelection:     "✗ Aucun élément sélectionné",
+		Quit:            "Au revoir! 👋\n",
+		FatalError:      "Erreur: %v\n\nAppuyez sur 'q' pour quitter.\n",
+
+		ConfirmTitle: "Renommer:",
+		ConfirmOld:   "Ancien",
+		ConfirmNew:   "Nouveau",
+		ConfirmEdit:  "Modifier le nom:",
+		ConfirmKeys:  "[Enter] Confirmer  [Esc] Annuler",
+
+		GitWarnTitle:  "⚠ Modifications indexées non validées:",
+		GitWarnPrompt: "Renommer quand même avec git mv ?",
+		YesNoKeys:     "[y] Oui  [n] Non",
+
+		BulkPreview:     "Aperçu du renommage en masse:",
+		BulkPreviewKeys: "[Enter] Confirmer  [Esc] Retour",
+		BulkHelpKeys:    "[Espace] Sélectionner  [a] Tout sélectionner  [Enter] Aperçu  [Esc] Annuler",
+
+		HelpParent: "parent",
+		HelpOpen:   "ouvrir",
+		HelpRename: "renommer",
+		HelpBulk:   "renommage en masse",
+		HelpUndo:   "annuler",
+	},
+	"en": {
+		File:        "File",
+		Folder:      "Folder",
+		Renaming:    "Renaming...",
+		Translating: "Translating...",
+
+		Title:           "File browser",
+		NamePlaceholder: "New name...",
+		Progress:        "Translating %d/%d…",
+		RenameSuccess:   "✓ Renamed: %s → %s",
+		ErrorStatus:     "✗ Error: %v",
+		BulkSuccess:     "✓ %d item(s) renamed",
+		BulkPartial:     "✓ %d renamed, ✗ %d failed",
+		UndoSuccess:     "↺ %d rename(s) undone",
+		UndoNone:        "no rename to undo",
+		NoTranslation:   "✗ No translation available",
+		NoSelection:     "✗ No item selected",
+		Quit:            "Goodbye! 👋\n",
+		FatalError:      "Error: %v\n\nPress 'q' to quit.\n",
+
+		ConfirmTitle: "Rename:",
+		ConfirmOld:   "Old",
+		ConfirmNew:   "New",
+		ConfirmEdit:  "Edit name:",
+		ConfirmKeys:  "[Enter] Confirm  [Esc] Cancel",
+
+		GitWarnTitle:  "⚠ Uncommitted staged changes:",
+		GitWarnPrompt: "Rename anyway with git mv?",
+		YesNoKeys:     "[y] Yes  [n] No",
+
+		BulkPreview:     "Bulk rename preview:",
+		BulkPreviewKeys: "[Enter] Confirm  [Esc] Back",
+		BulkHelpKeys:    "[Space] Select  [a] Select all  [Enter] Preview  [Esc] Cancel",
+
+		HelpParent: "parent",
+		HelpOpen:   "open",
+		HelpRename: "rename",
+		HelpBulk:   "bulk rename",
+		HelpUndo:   "undo",
+	},
+}
+
+// currentLocale returns the UI string bundle for toLang, falling back to
+// English for languages without a dedicated bundle.
+func currentLocale() locale {
+	if l, ok := locales[toLang]; ok {
+		return l
+	}
+	return locales["en"]
+}
+
+// cacheFlushInterval controls how many Set calls accumulate before the
+// on-disk cache is opportunistically flushed, so a crash loses at most a
+// handful of translations.
+const cacheFlushInterval = 20
+
+// cacheEntry is one persisted translation, tagged with the language pair it
+// was produced for and when, so stale or mismatched-language entries can be
+// pruned or ignored instead of poisoning results.
+type cacheEntry struct {
+	Translation string    `yaml:"translation"`
+	From        string    `yaml:"from"`
+	To          string    `yaml:"to"`
+	Timestamp   time.Time `yaml:"timestamp"`
+}
+
+// Translation cache to avoid repeated API calls. Backed by an in-memory map
+// that mirrors a YAML file on disk, so translations survive across runs.
 type translationCache struct {
-	mu    sync.RWMutex
-	cache map[string]string
+	mu      sync.RWMutex
+	entries map[string]cacheEntry
+	path    string
+	writes  int
 }
 
+// Get returns the cached translation for key, but only if it was produced
+// for the currently configured fromLang/toLang pair.
 func (tc *translationCache) Get(key string) (string, bool) {
 	tc.mu.RLock()
 	defer tc.mu.RUnlock()
-	val, ok := tc.cache[key]
-	return val, ok
+	entry, ok := tc.entries[key]
+	if !ok || entry.From != fromLang || entry.To != toLang {
+		return "", false
+	}
+	return entry.Translation, true
 }
 
 func (tc *translationCache) Set(key, val string) {
+	tc.mu.Lock()
+	tc.entries[key] = cacheEntry{
+		Translation: val,
+		From:        fromLang,
+		To:          toLang,
+		Timestamp:   time.Now(),
+	}
+	tc.writes++
+	shouldFlush := tc.writes >= cacheFlushInterval && tc.path != ""
+	if shouldFlush {
+		tc.writes = 0
+	}
+	path := tc.path
+	tc.mu.Unlock()
+
+	if shouldFlush {
+		// Best-effort: a failed periodic flush just means we fall back to
+		// the save-on-shutdown path.
+		_ = tc.Save(path)
+	}
+}
+
+// Load populates the cache from the YAML file at path. A missing file is
+// not an error; the cache simply starts empty.
+func (tc *translationCache) Load(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	var entries map[string]cacheEntry
+	if err := yaml.Unmarshal(data, &entries); err != nil {
+		return err
+	}
+
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	tc.path = path
+	if entries != nil {
+		tc.entries = entries
+	}
+	return nil
+}
+
+// Save writes the cache to path as YAML, creating its parent directory if
+// needed.
+func (tc *translationCache) Save(path string) error {
+	if path == "" {
+		return nil
+	}
+
+	tc.mu.RLock()
+	entries := make(map[string]cacheEntry, len(tc.entries))
+	for k, v := range tc.entries {
+		entries[k] = v
+	}
+	tc.mu.RUnlock()
+
+	data, err := yaml.Marshal(entries)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// Prune removes entries older than olderThan, so stale translations don't
+// accumulate forever.
+func (tc *translationCache) Prune(olderThan time.Duration) {
 	tc.mu.Lock()
 	defer tc.mu.Unlock()
-	tc.cache[key] = val
+	cutoff := time.Now().Add(-olderThan)
+	for k, v := range tc.entries {
+		if v.Timestamp.Before(cutoff) {
+			delete(tc.entries, k)
+		}
+	}
+}
+
+// defaultCachePath returns the on-disk location for the persistent
+// translation cache, under the user's config directory.
+func defaultCachePath() string {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		configDir = "."
+	}
+	return filepath.Join(configDir, "one-h-whack", "cache.yaml")
+}
+
+// appConfig holds user settings loaded from the config file: which
+// translation backend to use, its credentials/endpoints, and the default
+// language pair.
+type appConfig struct {
+	Backend     string `yaml:"backend"` // "google", "libre", "deepl", or "static"
+	From        string `yaml:"from"`
+	To          string `yaml:"to"`
+	LibreURL    string `yaml:"libre_url"`
+	LibreAPIKey string `yaml:"libre_api_key"`
+	DeepLAPIURL string `yaml:"deepl_api_url"`
+	DeepLAPIKey string `yaml:"deepl_api_key"`
+}
+
+// defaultConfigPath returns the on-disk location of the config file, under
+// the user's config directory.
+func defaultConfigPath() string {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		configDir = "."
+	}
+	return filepath.Join(configDir, "one-h-whack", "config.yaml")
+}
+
+// loadConfig reads path if present and overlays it on top of sane defaults.
+// A missing config file is not an error.
+func loadConfig(path string) (appConfig, error) {
+	cfg := appConfig{
+		Backend:     "google",
+		From:        "en",
+		To:          "fr",
+		LibreURL:    "https://libretranslate.com",
+		DeepLAPIURL: "https://api-free.deepl.com/v2/translate",
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cfg, nil
+		}
+		return cfg, err
+	}
+
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return cfg, err
+	}
+	return cfg, nil
+}
+
+// buildTranslator selects the Translator implementation named by
+// cfg.Backend, falling back to the Google backend for an unknown value.
+func buildTranslator(cfg appConfig) Translator {
+	switch cfg.Backend {
+	case "libre":
+		return libreTranslator{baseURL: cfg.LibreURL, apiKey: cfg.LibreAPIKey}
+	case "deepl":
+		return deeplTranslator{apiURL: cfg.DeepLAPIURL, apiKey: cfg.DeepLAPIKey}
+	case "static":
+		return staticTranslator{}
+	default:
+		return googleTranslator{}
+	}
 }
 
 var (
@@ -65,7 +399,7 @@ var (
 
 	// Global translation cache and client
 	cache = &translationCache{
-		cache: make(map[string]string),
+		entries: make(map[string]cacheEntry),
 	}
 )
 
@@ -81,27 +415,56 @@ type renameCompleteMsg struct {
 	err     error
 }
 
+// bulkRenameCompleteMsg reports the per-file outcome of a bulkRenameCmd run.
+type bulkRenameCompleteMsg struct {
+	results []renameCompleteMsg
+}
+
+// undoCompleteMsg reports the outcome of reversing the last undo-log batch.
+type undoCompleteMsg struct {
+	results []renameCompleteMsg
+	err     error
+}
+
+// fsWatchMsg carries a single raw fsnotify event (or error) up to Update.
+type fsWatchMsg struct {
+	event fsnotify.Event
+	err   error
+}
+
+// watchRefreshMsg fires after watchDebounceDelay has elapsed with no new
+// events for generation gen. Stale generations (superseded by a later event
+// or a directory change) are ignored.
+type watchRefreshMsg struct {
+	gen int
+}
+
 type item struct {
 	title       string
 	translation string
 	path        string
 	isDir       bool
 	translating bool
+	selected    bool
 }
 
 func (i item) Title() string {
+	title := i.title
+	if i.selected {
+		title = "☑ " + title
+	}
 	if i.translating {
-		return i.title + " ⏳"
+		return title + " ⏳"
 	}
 	if i.isDir {
-		return i.title + " 📁"
+		return title + " 📁"
 	}
-	return i.title
+	return title
 }
 
 func (i item) Description() string {
 	if i.translation == "" {
-		return "Traduction en cours..."
+		return currentLocale().Translating
 	}
 	return i.translation
 }
@@ -113,134 +476,786 @@ type viewMode int
 const (
 	normalMode viewMode = iota
 	confirmRenameMode
+	bulkRenameMode
+	bulkConfirmMode
+	gitWarnRenameMode
+	bulkGitWarnRenameMode
 )
 
+// renamePair is one proposed old-name/new-name rename, used both to render
+// the bulk rename preview and to drive bulkRenameCmd.
+type renamePair struct {
+	oldPath string
+	newPath string
+}
+
 type model struct {
-	list          list.Model
-	currentPath   string
-	err           error
-	quitting      bool
-	mode          viewMode
-	confirmInput  textinput.Model
-	itemToRename  item
-	proposedName  string
-	statusMessage string
+	list            list.Model
+	currentPath     string
+	err             error
+	quitting        bool
+	mode            viewMode
+	confirmInput    textinput.Model
+	itemToRename    item
+	proposedName    string
+	statusMessage   string
+	watcher         *fsnotify.Watcher
+	watchGen        int
+	bulkPreview     []renamePair
+	translationGen  int
+	pendingRename   renamePair
+	stagedConflicts []string
+}
+
+// Translator abstracts over the backend used to translate a single word or
+// phrase, so the app can be pointed at different services (or none at all)
+// without touching the caching/UI logic in translateText.
+type Translator interface {
+	Translate(text, from, to string) (string, error)
+}
+
+// errNoTranslation signals that a backend has no translation for text,
+// distinct from a transient failure, so callers can fall back quietly.
+var errNoTranslation = fmt.Errorf("no translation available")
+
+// googleTranslator calls the existing gopkg.gilang.dev/google-translate
+// client, which is known to panic on some inputs.
+type googleTranslator struct{}
+
+func (googleTranslator) Translate(text, from, to string) (string, error) {
+	var translated string
+	var err error
+
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				// If translation panics, just report it as an error.
+				err = fmt.Errorf("translation panic: %v", r)
+			}
+		}()
+
+		value := params.Translate{
+			Text: text,
+			From: from,
+			To:   to,
+		}
+
+		result, translateErr := gt.TranslateWithParam(value)
+		if translateErr != nil {
+			err = translateErr
+			return
+		}
+		translated = result.Text
+	}()
+
+	if err != nil {
+		return "", err
+	}
+	return translated, nil
+}
+
+// libreTranslator talks to a LibreTranslate-compatible HTTP endpoint.
+type libreTranslator struct {
+	baseURL string
+	apiKey  string
+}
+
+func (lt libreTranslator) Translate(text, from, to string) (string, error) {
+	body, err := json.Marshal(map[string]string{
+		"q":       text,
+		"source":  from,
+		"target":  to,
+		"format":  "text",
+		"api_key": lt.apiKey,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := http.Post(strings.TrimRight(lt.baseURL, "/")+"/translate",
+		"application/json", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("libretranslate: unexpected status %s", resp.Status)
+	}
+
+	var result struct {
+		TranslatedText string `json:"translatedText"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", err
+	}
+	return result.TranslatedText, nil
+}
+
+// deeplTranslator talks to the DeepL HTTP API.
+type deeplTranslator struct {
+	apiURL string
+	apiKey string
+}
+
+func (dt deeplTranslator) Translate(text, from, to string) (string, error) {
+	form := url.Values{
+		"auth_key":    {dt.apiKey},
+		"text":        {text},
+		"source_lang": {strings.ToUpper(from)},
+		"target_lang": {strings.ToUpper(to)},
+	}
+
+	resp, err := http.PostForm(dt.apiURL, form)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("deepl: unexpected status %s", resp.Status)
+	}
+
+	var result struct {
+		Translations []struct {
+			Text string `json:"text"`
+		} `json:"translations"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", err
+	}
+	if len(result.Translations) == 0 {
+		return "", errNoTranslation
+	}
+	return result.Translations[0].Text, nil
+}
+
+// staticTranslator only ever consults staticTranslations, so it works fully
+// offline at the cost of only knowing a handful of common directory names,
+// and only into French.
+type staticTranslator struct{}
+
+func (staticTranslator) Translate(text, from, to string) (string, error) {
+	if to != "fr" {
+		return "", errNoTranslation
+	}
+	if trans, ok := staticTranslations[text]; ok {
+		return trans, nil
+	}
+	for key, val := range staticTranslations {
+		if strings.EqualFold(key, text) {
+			return val, nil
+		}
+	}
+	return "", errNoTranslation
+}
+
+// translator is the backend selected at startup via the config file.
+var translator Translator = googleTranslator{}
+
+const (
+	// translateMaxRetries bounds how many attempts a single translation
+	// gets before giving up and falling back to the original name.
+	translateMaxRetries = 3
+	// translateRetryBaseDelay is the backoff before the first retry;
+	// later retries double it.
+	translateRetryBaseDelay = 200 * time.Millisecond
+	// circuitBreakerThreshold is how many consecutive translateWithRetry
+	// failures trip the breaker, after which calls fail fast instead of
+	// hammering an already-struggling backend.
+	circuitBreakerThreshold = 5
+	// circuitBreakerCooldown is how long the breaker stays open before
+	// letting a single probe call through to check whether the backend has
+	// recovered (a "half-open" retry).
+	circuitBreakerCooldown = 30 * time.Second
+)
+
+// errCircuitOpen is returned by translateWithRetry while the circuit
+// breaker is tripped.
+var errCircuitOpen = fmt.Errorf("translation backend circuit open")
+
+var (
+	circuitMu           sync.Mutex
+	consecutiveFailures int
+	circuitOpenedAt     time.Time
+	circuitProbing      bool
+)
+
+// translateWithRetry calls translator.Translate with exponential backoff on
+// transient errors, and fails fast via the circuit breaker once too many
+// consecutive calls have failed (each after exhausting its retries). Once circuitBreakerCooldown
+// has passed since the breaker tripped, it goes half-open: exactly one call
+// (across all worker-pool goroutines) is let through to probe the backend,
+// and a success closes the breaker again.
+func translateWithRetry(text, from, to string) (string, error) {
+	circuitMu.Lock()
+	open := consecutiveFailures >= circuitBreakerThreshold
+	halfOpen := false
+	if open && !circuitProbing && time.Since(circuitOpenedAt) >= circuitBreakerCooldown {
+		halfOpen = true
+		circuitProbing = true
+		open = false
+	}
+	circuitMu.Unlock()
+	if open {
+		return "", errCircuitOpen
+	}
+
+	var lastErr error
+	for attempt := 0; attempt < translateMaxRetries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(translateRetryBaseDelay * (1 << uint(attempt-1)))
+		}
+
+		result, err := translator.Translate(text, from, to)
+		if err == nil {
+			circuitMu.Lock()
+			consecutiveFailures = 0
+			circuitProbing = false
+			circuitMu.Unlock()
+			return result, nil
+		}
+		if errors.Is(err, errNoTranslation) {
+			// Not a transient backend failure: there's nothing to retry and
+			// nothing to hold against the circuit breaker.
+			if halfOpen {
+				circuitMu.Lock()
+				circuitProbing = false
+				circuitMu.Unlock()
+			}
+			return "", err
+		}
+		lastErr = err
+	}
+
+	circuitMu.Lock()
+	consecutiveFailures++
+	if consecutiveFailures == circuitBreakerThreshold || halfOpen {
+		circuitOpenedAt = time.Now()
+	}
+	if halfOpen {
+		circuitProbing = false
+	}
+	circuitMu.Unlock()
+	return "", lastErr
+}
+
+func translateText(text string) (string, error) {
+	// Check cache first
+	if trans, ok := cache.Get(text); ok {
+		return trans, nil
+	}
+
+	// staticTranslations is French-only data; only consult it when that's
+	// actually the configured target, so e.g. --to es doesn't get "Desktop"
+	// resolved to "Bureau" and cached under the wrong language pair.
+	if toLang == "fr" {
+		if trans, ok := staticTranslations[text]; ok {
+			cache.Set(text, trans)
+			return trans, nil
+		}
+
+		// Case-insensitive check
+		for key, val := range staticTranslations {
+			if strings.EqualFold(key, text) {
+				cache.Set(text, val)
+				return val, nil
+			}
+		}
+	}
+
+	// Remove file extensions for better translation
+	nameWithoutExt := strings.TrimSuffix(text, filepath.Ext(text))
+	ext := filepath.Ext(text)
+
+	// Skip translation for very short names or single characters
+	if len(nameWithoutExt) <= 1 {
+		cache.Set(text, text)
+		return text, nil
+	}
+
+	// Skip translation for names that are all numbers or special chars
+	if !containsLetters(nameWithoutExt) {
+		cache.Set(text, text)
+		return text, nil
+	}
+
+	translated, err := translateWithRetry(nameWithoutExt, fromLang, toLang)
+	if err != nil {
+		// Fallback to original text on error
+		cache.Set(text, text)
+		return text, nil
+	}
+
+	translated += ext
+	cache.Set(text, translated)
+	return translated, nil
+}
+
+func containsLetters(s string) bool {
+	for _, r := range s {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') {
+			return true
+		}
+	}
+	return false
+}
+
+// translationJob is one name queued for translation, tagged with the
+// listing generation it belongs to so stale progress can be told apart from
+// the directory currently on screen.
+type translationJob struct {
+	name string
+	gen  int
+}
+
+// translationProgressMsg reports how many of the current generation's
+// queued translations have completed, for display in the list title.
+type translationProgressMsg struct {
+	gen   int
+	done  int
+	total int
+}
+
+// translationQueue feeds the worker pool; translationResults carries their
+// output (progress and completed-translation messages) back to Update via
+// translationResultsCmd.
+var (
+	translationQueue   chan translationJob
+	translationResults chan tea.Msg
+)
+
+// progressCounts tracks done/total for one listing generation.
+type progressCounts struct {
+	mu    sync.Mutex
+	total int
+	done  int
+}
+
+var (
+	progressMu sync.Mutex
+	progress   = map[int]*progressCounts{}
+)
+
+func progressFor(gen int) *progressCounts {
+	progressMu.Lock()
+	defer progressMu.Unlock()
+	counts, ok := progress[gen]
+	if !ok {
+		counts = &progressCounts{}
+		progress[gen] = counts
+	}
+	return counts
+}
+
+// startNewGeneration drops progress bookkeeping for every generation but
+// gen, since only the directory currently on screen is ever displayed.
+func startNewGeneration(gen int) {
+	progressMu.Lock()
+	defer progressMu.Unlock()
+	progress = map[int]*progressCounts{gen: {}}
+}
+
+// startTranslationWorkers launches a bounded pool of n workers that
+// serialize translation requests through translationQueue, so a large
+// directory doesn't fire dozens of concurrent API calls at once.
+func startTranslationWorkers(n int) {
+	if n < 1 {
+		n = 1
+	}
+	translationQueue = make(chan translationJob, 256)
+	translationResults = make(chan tea.Msg, 256)
+
+	for i := 0; i < n; i++ {
+		go func() {
+			for job := range translationQueue {
+				translation, err := translateText(job.name)
+				if err != nil {
+					translation = job.name // Fallback to original
+				}
+
+				counts := progressFor(job.gen)
+				counts.mu.Lock()
+				counts.done++
+				done, total := counts.done, counts.total
+				counts.mu.Unlock()
+
+				translationResults <- translationProgressMsg{gen: job.gen, done: done, total: total}
+				translationResults <- translationMsg{name: job.name, translation: translation}
+			}
+		}()
+	}
+}
+
+// queueTranslation enqueues name for translation under generation gen,
+// counting it towards that generation's progress total.
+func queueTranslation(name string, gen int) {
+	counts := progressFor(gen)
+	counts.mu.Lock()
+	counts.total++
+	counts.mu.Unlock()
+
+	translationQueue <- translationJob{name: name, gen: gen}
+}
+
+// translationResultsCmd blocks for the next queued translation result and
+// emits it as a tea.Msg. Update re-issues this command after every result so
+// the pool keeps being drained for the life of the program.
+func translationResultsCmd() tea.Cmd {
+	return func() tea.Msg {
+		return <-translationResults
+	}
+}
+
+// noGit forces performRename to always use os.Rename, set from --no-git.
+var noGit bool
+
+// gitRepoRoot walks up from dir looking for a .git entry, so callers can
+// tell whether a path is inside a Git working tree without shelling out.
+func gitRepoRoot(dir string) (string, bool) {
+	for {
+		if _, err := os.Stat(filepath.Join(dir, ".git")); err == nil {
+			return dir, true
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", false
+		}
+		dir = parent
+	}
+}
+
+// gitBranch reads repoRoot/.git/HEAD and returns the branch name, or a short
+// commit hash if HEAD is detached.
+func gitBranch(repoRoot string) string {
+	data, err := os.ReadFile(filepath.Join(repoRoot, ".git", "HEAD"))
+	if err != nil {
+		return ""
+	}
+
+	head := strings.TrimSpace(string(data))
+	const refPrefix = "ref: refs/heads/"
+	if strings.HasPrefix(head, refPrefix) {
+		return strings.TrimPrefix(head, refPrefix)
+	}
+	if len(head) > 7 {
+		return head[:7]
+	}
+	return head
+}
+
+// hasStagedChanges reports whether path has changes staged in the index, in
+// which case renaming it out from under Git could lose uncommitted work.
+func hasStagedChanges(repoRoot, path string) bool {
+	relPath, err := filepath.Rel(repoRoot, path)
+	if err != nil {
+		relPath = path
+	}
+
+	out, err := exec.Command("git", "-C", repoRoot, "status", "--porcelain", "--", relPath).Output()
+	if err != nil {
+		return false
+	}
+
+	for _, line := range strings.Split(string(out), "\n") {
+		if len(line) < 2 {
+			continue
+		}
+		indexStatus := line[0]
+		if indexStatus != ' ' && indexStatus != '?' {
+			return true
+		}
+	}
+	return false
+}
+
+// gitMove renames oldPath to newPath (same directory) via `git mv`, so the
+// file's history and blame survive the rename.
+func gitMove(oldPath, newPath string) error {
+	cmd := exec.Command("git", "mv", filepath.Base(oldPath), filepath.Base(newPath))
+	cmd.Dir = filepath.Dir(oldPath)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("git mv: %w: %s", err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// isTracked reports whether path is tracked by the Git repo rooted at
+// repoRoot. Untracked files (e.g. new, gitignored) can't be moved with
+// `git mv`, which exits non-zero for them.
+func isTracked(repoRoot, path string) bool {
+	relPath, err := filepath.Rel(repoRoot, path)
+	if err != nil {
+		relPath = path
+	}
+	err = exec.Command("git", "-C", repoRoot, "ls-files", "--error-unmatch", "--", relPath).Run()
+	return err == nil
+}
+
+// buildTitle renders the list title for path, appending the enclosing Git
+// repo name and branch when path is inside a working tree.
+func buildTitle(path string) string {
+	title := fmt.Sprintf("%s - %s", currentLocale().Title, path)
+
+	root, ok := gitRepoRoot(path)
+	if !ok {
+		return title
+	}
+	return fmt.Sprintf("%s [%s:%s]", title, filepath.Base(root), gitBranch(root))
+}
+
+// performRename renames oldPath to newPath, preferring `git mv` whenever the
+// file lives in a Git working tree and --no-git wasn't passed. Untracked
+// files fall back to a plain os.Rename, since `git mv` only ever touches
+// files Git already knows about; a tracked file still routes through
+// `git mv` and its error (e.g. a destination that already exists) is
+// propagated rather than silently papered over with an overwrite.
+func performRename(oldPath, newPath string) error {
+	if !noGit {
+		if root, ok := gitRepoRoot(filepath.Dir(oldPath)); ok {
+			if !isTracked(root, oldPath) {
+				return os.Rename(oldPath, newPath)
+			}
+			return gitMove(oldPath, newPath)
+		}
+	}
+	return os.Rename(oldPath, newPath)
+}
+
+func renameFileCmd(oldPath, newPath string) tea.Cmd {
+	return func() tea.Msg {
+		err := performRename(oldPath, newPath)
+		return renameCompleteMsg{
+			oldPath: oldPath,
+			newPath: newPath,
+			success: err == nil,
+			err:     err,
+		}
+	}
+}
+
+// historyEntry is one undo-log record: a single rename performed as part of
+// a bulk batch. Entries sharing a BatchID were renamed together and are
+// undone together.
+type historyEntry struct {
+	Timestamp string `json:"timestamp"`
+	BatchID   string `json:"batchId"`
+	OldPath   string `json:"oldPath"`
+	NewPath   string `json:"newPath"`
+}
+
+// defaultHistoryPath returns the on-disk location of the bulk-rename undo
+// log.
+func defaultHistoryPath() string {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		homeDir = "."
+	}
+	return filepath.Join(homeDir, ".local", "state", "one-h-whack", "history.jsonl")
+}
+
+// appendHistory appends entries to the undo log at path, one JSON object per
+// line, creating the file and its parent directory if needed.
+func appendHistory(path string, entries []historyEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	enc := json.NewEncoder(f)
+	for _, entry := range entries {
+		if err := enc.Encode(entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readHistory loads every entry from the undo log, in file order.
+func readHistory(path string) ([]historyEntry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var entries []historyEntry
+	for _, line := range strings.Split(strings.TrimRight(string(data), "\n"), "\n") {
+		if line == "" {
+			continue
+		}
+		var entry historyEntry
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
 }
 
-func translateText(text string) (string, error) {
-	// Check cache first
-	if trans, ok := cache.Get(text); ok {
-		return trans, nil
+// writeHistory overwrites the undo log with entries, replacing its previous
+// contents entirely.
+func writeHistory(path string, entries []historyEntry) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
 	}
 
-	// Check static translations
-	if trans, ok := staticTranslations[text]; ok {
-		cache.Set(text, trans)
-		return trans, nil
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
 	}
+	defer f.Close()
 
-	// Case-insensitive check
-	for key, val := range staticTranslations {
-		if strings.EqualFold(key, text) {
-			cache.Set(text, val)
-			return val, nil
+	enc := json.NewEncoder(f)
+	for _, entry := range entries {
+		if err := enc.Encode(entry); err != nil {
+			return err
 		}
 	}
+	return nil
+}
 
-	// Remove file extensions for better translation
-	nameWithoutExt := strings.TrimSuffix(text, filepath.Ext(text))
-	ext := filepath.Ext(text)
+// bulkRenameCmd renames every pair (via performRename) and records the
+// successful ones as a single batch in the undo log.
+func bulkRenameCmd(pairs []renamePair, batchID string) tea.Cmd {
+	return func() tea.Msg {
+		results := make([]renameCompleteMsg, 0, len(pairs))
+		var logged []historyEntry
+
+		for _, pair := range pairs {
+			err := performRename(pair.oldPath, pair.newPath)
+			results = append(results, renameCompleteMsg{
+				oldPath: pair.oldPath,
+				newPath: pair.newPath,
+				success: err == nil,
+				err:     err,
+			})
+			if err == nil {
+				logged = append(logged, historyEntry{
+					Timestamp: batchID,
+					BatchID:   batchID,
+					OldPath:   pair.oldPath,
+					NewPath:   pair.newPath,
+				})
+			}
+		}
 
-	// Skip translation for very short names or single characters
-	if len(nameWithoutExt) <= 1 {
-		cache.Set(text, text)
-		return text, nil
-	}
+		if err := appendHistory(defaultHistoryPath(), logged); err != nil {
+			// The renames already happened; losing the undo log just means
+			// this batch can't be undone, which isn't worth failing over.
+			_ = err
+		}
 
-	// Skip translation for names that are all numbers or special chars
-	if !containsLetters(nameWithoutExt) {
-		cache.Set(text, text)
-		return text, nil
+		return bulkRenameCompleteMsg{results: results}
 	}
+}
 
-	// Use defer/recover to catch panics from the translation library
-	var translated string
-	var err error
+// undoLastBatchCmd reverses every rename belonging to the most recently
+// logged batch, then removes that batch from the undo log.
+func undoLastBatchCmd() tea.Cmd {
+	return func() tea.Msg {
+		path := defaultHistoryPath()
+		entries, err := readHistory(path)
+		if err != nil {
+			return undoCompleteMsg{err: err}
+		}
+		if len(entries) == 0 {
+			return undoCompleteMsg{err: errors.New(currentLocale().UndoNone)}
+		}
 
-	func() {
-		defer func() {
-			if r := recover(); r != nil {
-				// If translation panics, just use the original text
-				err = fmt.Errorf("translation panic: %v", r)
+		lastBatchID := entries[len(entries)-1].BatchID
+		var toUndo, remaining []historyEntry
+		for _, entry := range entries {
+			if entry.BatchID == lastBatchID {
+				toUndo = append(toUndo, entry)
+			} else {
+				remaining = append(remaining, entry)
 			}
-		}()
+		}
 
-		value := params.Translate{
-			Text: nameWithoutExt,
-			From: "en",
-			To:   "fr",
+		results := make([]renameCompleteMsg, 0, len(toUndo))
+		for i := len(toUndo) - 1; i >= 0; i-- {
+			entry := toUndo[i]
+			err := performRename(entry.NewPath, entry.OldPath)
+			results = append(results, renameCompleteMsg{
+				oldPath: entry.NewPath,
+				newPath: entry.OldPath,
+				success: err == nil,
+				err:     err,
+			})
 		}
 
-		result, translateErr := gt.TranslateWithParam(value)
-		if translateErr != nil {
-			err = translateErr
-			return
+		if err := writeHistory(path, remaining); err != nil {
+			return undoCompleteMsg{results: results, err: err}
 		}
-		translated = result.Text + ext
-	}()
 
-	if err != nil {
-		// Fallback to original text on error
-		cache.Set(text, text)
-		return text, nil
+		return undoCompleteMsg{results: results}
 	}
-
-	cache.Set(text, translated)
-	return translated, nil
 }
 
-func containsLetters(s string) bool {
-	for _, r := range s {
-		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') {
-			return true
-		}
+// startWatcher opens an fsnotify watcher on path. The caller is responsible
+// for closing any previously-open watcher before replacing it.
+func startWatcher(path string) (*fsnotify.Watcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
 	}
-	return false
+	if err := watcher.Add(path); err != nil {
+		watcher.Close()
+		return nil, err
+	}
+	return watcher, nil
 }
 
-func translateNameCmd(name string) tea.Cmd {
+// watchDirectoryCmd blocks on the watcher's channels and emits a single
+// fsWatchMsg per event or error. Update re-issues this command after each
+// message so the watcher keeps being drained for the lifetime of watcher.
+func watchDirectoryCmd(watcher *fsnotify.Watcher) tea.Cmd {
 	return func() tea.Msg {
-		translation, err := translateText(name)
-		if err != nil {
-			translation = name // Fallback to original
-		}
-		return translationMsg{
-			name:        name,
-			translation: translation,
+		select {
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			return fsWatchMsg{event: event}
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			return fsWatchMsg{err: err}
 		}
 	}
 }
 
-func renameFileCmd(oldPath, newPath string) tea.Cmd {
-	return func() tea.Msg {
-		err := os.Rename(oldPath, newPath)
-		return renameCompleteMsg{
-			oldPath: oldPath,
-			newPath: newPath,
-			success: err == nil,
-			err:     err,
-		}
-	}
+// debounceRefreshCmd schedules a watchRefreshMsg for generation gen after
+// watchDebounceDelay, letting Update drop it if a newer event arrived first.
+func debounceRefreshCmd(gen int) tea.Cmd {
+	return tea.Tick(watchDebounceDelay, func(time.Time) tea.Msg {
+		return watchRefreshMsg{gen: gen}
+	})
 }
 
-func getDirectoryItems(path string) ([]list.Item, []tea.Cmd) {
+// listDirectoryItems lists path without queuing any translation jobs, so it
+// can be called before the worker pool's results are being drained (e.g.
+// from initialModel, before the program is running).
+func listDirectoryItems(path string) []list.Item {
 	entries, err := os.ReadDir(path)
 	if err != nil {
-		return nil, nil
+		return nil
 	}
 
 	items := make([]list.Item, 0)
-	cmds := make([]tea.Cmd, 0)
 
 	for _, entry := range entries {
 		// Skip hidden files
@@ -248,9 +1263,10 @@ func getDirectoryItems(path string) ([]list.Item, []tea.Cmd) {
 			continue
 		}
 
-		typeStr := "Fichier"
+		loc := currentLocale()
+		typeStr := loc.File
 		if entry.IsDir() {
-			typeStr = "Dossier"
+			typeStr = loc.Folder
 		}
 
 		// Check if translation is cached
@@ -268,14 +1284,52 @@ func getDirectoryItems(path string) ([]list.Item, []tea.Cmd) {
 			newItem.translation = fmt.Sprintf("%s → %s", typeStr, cached)
 		} else {
 			newItem.translation = fmt.Sprintf("%s → ...", typeStr)
-			// Queue translation
-			cmds = append(cmds, translateNameCmd(entry.Name()))
 		}
 
 		items = append(items, newItem)
 	}
 
-	return items, cmds
+	return items
+}
+
+// queuePendingItems queues a translation job, tagged with gen, for every item
+// in items still awaiting translation.
+func queuePendingItems(items []list.Item, gen int) {
+	for _, it := range items {
+		if it, ok := it.(item); ok && it.translating {
+			queueTranslation(it.title, gen)
+		}
+	}
+}
+
+// queuePendingTranslationsCmd wraps queuePendingItems as a tea.Cmd, so
+// queuing the initial listing's translation jobs can be deferred to Init()
+// instead of happening synchronously in initialModel. Queuing before the
+// program is running and translationResultsCmd's drain loop has started
+// risks deadlocking on a large directory once the buffered
+// translationQueue/translationResults channels fill up.
+func queuePendingTranslationsCmd(items []list.Item, gen int) tea.Cmd {
+	return func() tea.Msg {
+		startNewGeneration(gen)
+		queuePendingItems(items, gen)
+		return nil
+	}
+}
+
+// getDirectoryItems lists path and queues a translation job for every entry
+// not already cached, tagging each job with gen so its progress can be told
+// apart from any other generation still draining. Safe to call once the
+// program is running and results are being drained.
+func getDirectoryItems(path string, gen int) []list.Item {
+	items := listDirectoryItems(path)
+	if items == nil {
+		return nil
+	}
+	startNewGeneration(gen)
+	// Queue from a separate goroutine: this runs inside Update, and blocking
+	// here on a full translationQueue would stop the results from draining.
+	go queuePendingItems(items, gen)
+	return items
 }
 
 func initialModel() model {
@@ -284,7 +1338,9 @@ func initialModel() model {
 		homeDir = "."
 	}
 
-	items, _ := getDirectoryItems(homeDir)
+	// Translation jobs for this listing are queued later, from Init, once
+	// the program is actually running and draining results.
+	items := listDirectoryItems(homeDir)
 
 	delegate := list.NewDefaultDelegate()
 	delegate.Styles.SelectedTitle = lipgloss.NewStyle().
@@ -294,69 +1350,134 @@ func initialModel() model {
 		Foreground(lipgloss.Color("141"))
 
 	l := list.New(items, delegate, 0, 0)
-	l.Title = fmt.Sprintf("Navigateur de fichiers - %s", homeDir)
+	l.Title = buildTitle(homeDir)
 	l.Styles.Title = lipgloss.NewStyle().
 		Background(lipgloss.Color("62")).
 		Foreground(lipgloss.Color("230")).
 		Padding(0, 1)
 
 	l.AdditionalShortHelpKeys = func() []key.Binding {
+		loc := currentLocale()
 		return []key.Binding{
 			key.NewBinding(
 				key.WithKeys("left"),
-				key.WithHelp("←", "parent"),
+				key.WithHelp("←", loc.HelpParent),
 			),
 			key.NewBinding(
 				key.WithKeys("right"),
-				key.WithHelp("→", "ouvrir"),
+				key.WithHelp("→", loc.HelpOpen),
 			),
 			key.NewBinding(
 				key.WithKeys("enter"),
-				key.WithHelp("enter", "renommer"),
+				key.WithHelp("enter", loc.HelpRename),
+			),
+			key.NewBinding(
+				key.WithKeys("b"),
+				key.WithHelp("b", loc.HelpBulk),
+			),
+			key.NewBinding(
+				key.WithKeys("u"),
+				key.WithHelp("u", loc.HelpUndo),
 			),
 		}
 	}
 
 	ti := textinput.New()
-	ti.Placeholder = "Nouveau nom..."
+	ti.Placeholder = currentLocale().NamePlaceholder
 	ti.CharLimit = 255
 
+	watcher, _ := startWatcher(homeDir)
+
 	return model{
 		list:         l,
 		currentPath:  homeDir,
 		mode:         normalMode,
 		confirmInput: ti,
+		watcher:      watcher,
 	}
 }
 
 func (m model) Init() tea.Cmd {
-	// Trigger initial translations
-	items, cmds := getDirectoryItems(m.currentPath)
-	m.list.SetItems(items)
+	// initialModel already listed currentPath; queue its translation jobs
+	// here, now that the program is running and translationResultsCmd's
+	// drain loop is about to start, instead of queuing them synchronously
+	// in initialModel where nothing would be consuming results yet.
+	cmds := []tea.Cmd{
+		translationResultsCmd(),
+		queuePendingTranslationsCmd(m.list.Items(), m.translationGen),
+	}
+
+	if m.watcher != nil {
+		cmds = append(cmds, watchDirectoryCmd(m.watcher))
+	}
+
 	return tea.Batch(cmds...)
 }
 
 func (m model) navigateToDirectory(path string) (model, tea.Cmd) {
-	items, cmds := getDirectoryItems(path)
+	m.translationGen++
+	items := getDirectoryItems(path, m.translationGen)
 	m.currentPath = path
-	m.list.Title = fmt.Sprintf("Navigateur de fichiers - %s", m.currentPath)
+	m.list.Title = buildTitle(m.currentPath)
 	m.list.SetItems(items)
 	m.list.ResetSelected()
 	m.statusMessage = ""
+	// Any refresh still pending for the previous listing is now stale.
+	m.watchGen++
+
+	var cmds []tea.Cmd
+
+	if m.watcher != nil {
+		m.watcher.Close()
+		m.watcher = nil
+	}
+	if watcher, err := startWatcher(path); err == nil {
+		m.watcher = watcher
+		cmds = append(cmds, watchDirectoryCmd(watcher))
+	}
+
 	return m, tea.Batch(cmds...)
 }
 
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case fsWatchMsg:
+		if m.watcher == nil {
+			return m, nil
+		}
+		m.watchGen++
+		return m, tea.Batch(
+			watchDirectoryCmd(m.watcher),
+			debounceRefreshCmd(m.watchGen),
+		)
+
+	case watchRefreshMsg:
+		if msg.gen != m.watchGen {
+			// Superseded by a later event; this refresh is stale.
+			return m, nil
+		}
+		return m.navigateToDirectory(m.currentPath)
+
+	case translationProgressMsg:
+		if msg.gen == m.translationGen {
+			if msg.done < msg.total {
+				m.list.Title = fmt.Sprintf(currentLocale().Progress, msg.done, msg.total)
+			} else {
+				m.list.Title = buildTitle(m.currentPath)
+			}
+		}
+		return m, translationResultsCmd()
+
 	case translationMsg:
 		// Update the item with the translation
 		items := m.list.Items()
 		for i, itm := range items {
 			if listItem, ok := itm.(item); ok {
 				if listItem.title == msg.name {
-					typeStr := "Fichier"
+					loc := currentLocale()
+					typeStr := loc.File
 					if listItem.isDir {
-						typeStr = "Dossier"
+						typeStr = loc.Folder
 					}
 					listItem.translation = fmt.Sprintf("%s → %s",
 						typeStr, msg.translation)
@@ -367,20 +1488,52 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 		m.list.SetItems(items)
-		return m, nil
+		return m, translationResultsCmd()
 
 	case renameCompleteMsg:
 		m.mode = normalMode
 		if msg.success {
-			m.statusMessage = fmt.Sprintf("✓ Renommé: %s → %s",
+			m.statusMessage = fmt.Sprintf(currentLocale().RenameSuccess,
 				filepath.Base(msg.oldPath), filepath.Base(msg.newPath))
 			// Refresh directory
 			return m.navigateToDirectory(m.currentPath)
 		} else {
-			m.statusMessage = fmt.Sprintf("✗ Erreur: %v", msg.err)
+			m.statusMessage = fmt.Sprintf(currentLocale().ErrorStatus, msg.err)
 			return m, nil
 		}
 
+	case bulkRenameCompleteMsg:
+		m.mode = normalMode
+		m.bulkPreview = nil
+		succeeded, failed := 0, 0
+		for _, result := range msg.results {
+			if result.success {
+				succeeded++
+			} else {
+				failed++
+			}
+		}
+		if failed == 0 {
+			m.statusMessage = fmt.Sprintf(currentLocale().BulkSuccess, succeeded)
+		} else {
+			m.statusMessage = fmt.Sprintf(currentLocale().BulkPartial, succeeded, failed)
+		}
+		return m.navigateToDirectory(m.currentPath)
+
+	case undoCompleteMsg:
+		if msg.err != nil {
+			m.statusMessage = fmt.Sprintf(currentLocale().ErrorStatus, msg.err)
+			return m, nil
+		}
+		undone := 0
+		for _, result := range msg.results {
+			if result.success {
+				undone++
+			}
+		}
+		m.statusMessage = fmt.Sprintf(currentLocale().UndoSuccess, undone)
+		return m.navigateToDirectory(m.currentPath)
+
 	case tea.KeyMsg:
 		if m.mode == confirmRenameMode {
 			switch msg.String() {
@@ -398,7 +1551,16 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				oldPath := m.itemToRename.path
 				newPath := filepath.Join(filepath.Dir(oldPath), newName)
 
-				m.statusMessage = "Renommage en cours..."
+				if !noGit {
+					if root, ok := gitRepoRoot(filepath.Dir(oldPath)); ok && hasStagedChanges(root, oldPath) {
+						m.mode = gitWarnRenameMode
+						m.pendingRename = renamePair{oldPath: oldPath, newPath: newPath}
+						m.statusMessage = ""
+						return m, nil
+					}
+				}
+
+				m.statusMessage = currentLocale().Renaming
 				return m, renameFileCmd(oldPath, newPath)
 
 			default:
@@ -408,12 +1570,163 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
+		if m.mode == gitWarnRenameMode {
+			switch msg.String() {
+			case "y", "Y":
+				pending := m.pendingRename
+				m.mode = normalMode
+				m.statusMessage = currentLocale().Renaming
+				return m, renameFileCmd(pending.oldPath, pending.newPath)
+
+			case "n", "N", "esc":
+				m.mode = normalMode
+				m.statusMessage = ""
+				return m, nil
+			}
+			return m, nil
+		}
+
+		if m.mode == bulkRenameMode {
+			switch msg.String() {
+			case "esc":
+				items := m.list.Items()
+				for i, itm := range items {
+					if listItem, ok := itm.(item); ok && listItem.selected {
+						listItem.selected = false
+						items[i] = listItem
+					}
+				}
+				m.list.SetItems(items)
+				m.mode = normalMode
+				m.statusMessage = ""
+				return m, nil
+
+			case " ":
+				items := m.list.Items()
+				idx := m.list.Index()
+				if listItem, ok := items[idx].(item); ok {
+					translation, hasTranslation := cache.Get(listItem.title)
+					if hasTranslation && translation != listItem.title {
+						listItem.selected = !listItem.selected
+						items[idx] = listItem
+						m.list.SetItems(items)
+					}
+				}
+				return m, nil
+
+			case "a":
+				items := m.list.Items()
+				for i, itm := range items {
+					listItem, ok := itm.(item)
+					if !ok {
+						continue
+					}
+					translation, hasTranslation := cache.Get(listItem.title)
+					if hasTranslation && translation != listItem.title {
+						listItem.selected = true
+						items[i] = listItem
+					}
+				}
+				m.list.SetItems(items)
+				return m, nil
+
+			case "enter":
+				var pairs []renamePair
+				for _, itm := range m.list.Items() {
+					listItem, ok := itm.(item)
+					if !ok || !listItem.selected {
+						continue
+					}
+					translation, hasTranslation := cache.Get(listItem.title)
+					if !hasTranslation || translation == listItem.title {
+						continue
+					}
+					pairs = append(pairs, renamePair{
+						oldPath: listItem.path,
+						newPath: filepath.Join(filepath.Dir(listItem.path), translation),
+					})
+				}
+				if len(pairs) == 0 {
+					m.statusMessage = currentLocale().NoSelection
+					return m, nil
+				}
+				m.bulkPreview = pairs
+				m.mode = bulkConfirmMode
+				return m, nil
+			}
+
+			var cmd tea.Cmd
+			m.list, cmd = m.list.Update(msg)
+			return m, cmd
+		}
+
+		if m.mode == bulkConfirmMode {
+			switch msg.String() {
+			case "esc":
+				m.mode = bulkRenameMode
+				return m, nil
+
+			case "enter":
+				if !noGit {
+					var conflicts []string
+					for _, pair := range m.bulkPreview {
+						if root, ok := gitRepoRoot(filepath.Dir(pair.oldPath)); ok && hasStagedChanges(root, pair.oldPath) {
+							conflicts = append(conflicts, filepath.Base(pair.oldPath))
+						}
+					}
+					if len(conflicts) > 0 {
+						m.mode = bulkGitWarnRenameMode
+						m.stagedConflicts = conflicts
+						return m, nil
+					}
+				}
+
+				batchID := time.Now().Format(time.RFC3339Nano)
+				m.statusMessage = currentLocale().Renaming
+				return m, bulkRenameCmd(m.bulkPreview, batchID)
+			}
+			return m, nil
+		}
+
+		if m.mode == bulkGitWarnRenameMode {
+			switch msg.String() {
+			case "y", "Y":
+				batchID := time.Now().Format(time.RFC3339Nano)
+				m.mode = normalMode
+				m.stagedConflicts = nil
+				m.statusMessage = currentLocale().Renaming
+				return m, bulkRenameCmd(m.bulkPreview, batchID)
+
+			case "n", "N", "esc":
+				m.mode = bulkConfirmMode
+				m.stagedConflicts = nil
+				return m, nil
+			}
+			return m, nil
+		}
+
 		// Normal mode
 		switch msg.String() {
 		case "ctrl+c", "q":
 			m.quitting = true
+			if m.watcher != nil {
+				m.watcher.Close()
+			}
 			return m, tea.Quit
 
+		case "b":
+			// Enter bulk rename mode
+			if len(m.list.Items()) == 0 {
+				return m, nil
+			}
+			m.mode = bulkRenameMode
+			m.statusMessage = ""
+			return m, nil
+
+		case "u":
+			// Undo the last bulk rename batch
+			return m, undoLastBatchCmd()
+
 		case "left":
 			// Navigate to parent directory
 			parentPath := filepath.Dir(m.currentPath)
@@ -430,10 +1743,10 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, nil
 			}
 
-			// Get the French translation
+			// Get the cached translation
 			translation, _ := cache.Get(selectedItem.title)
 			if translation == "" || translation == selectedItem.title {
-				m.statusMessage = "✗ Pas de traduction disponible"
+				m.statusMessage = currentLocale().NoTranslation
 				return m, nil
 			}
 
@@ -470,13 +1783,14 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 }
 
 func (m model) View() string {
+	loc := currentLocale()
+
 	if m.quitting {
-		return "Au revoir! 👋\n"
+		return loc.Quit
 	}
 
 	if m.err != nil {
-		return fmt.Sprintf("Erreur: %v\n\nAppuyez sur 'q' pour quitter.\n",
-			m.err)
+		return fmt.Sprintf(loc.FatalError, m.err)
 	}
 
 	if m.mode == confirmRenameMode {
@@ -487,14 +1801,16 @@ func (m model) View() string {
 			Width(60)
 
 		content := fmt.Sprintf(
-			"Renommer:\n\n"+
-				"  Ancien: %s\n"+
-				"  Nouveau: %s\n\n"+
-				"Modifier le nom:\n%s\n\n"+
-				"[Enter] Confirmer  [Esc] Annuler",
-			m.itemToRename.title,
-			m.proposedName,
-			m.confirmInput.View(),
+			"%s\n\n"+
+				"  %s: %s\n"+
+				"  %s: %s\n\n"+
+				"%s\n%s\n\n"+
+				"%s",
+			loc.ConfirmTitle,
+			loc.ConfirmOld, m.itemToRename.title,
+			loc.ConfirmNew, m.proposedName,
+			loc.ConfirmEdit, m.confirmInput.View(),
+			loc.ConfirmKeys,
 		)
 
 		return lipgloss.Place(
@@ -506,8 +1822,98 @@ func (m model) View() string {
 		)
 	}
 
+	if m.mode == gitWarnRenameMode {
+		warnStyle := lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(lipgloss.Color("196")).
+			Padding(1, 2).
+			Width(60)
+
+		content := fmt.Sprintf(
+			"%s\n\n"+
+				"  %s\n\n"+
+				"%s\n\n"+
+				"%s",
+			loc.GitWarnTitle,
+			filepath.Base(m.pendingRename.oldPath),
+			loc.GitWarnPrompt,
+			loc.YesNoKeys,
+		)
+
+		return lipgloss.Place(
+			m.list.Width(),
+			m.list.Height(),
+			lipgloss.Center,
+			lipgloss.Center,
+			warnStyle.Render(content),
+		)
+	}
+
+	if m.mode == bulkGitWarnRenameMode {
+		warnStyle := lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(lipgloss.Color("196")).
+			Padding(1, 2).
+			Width(60)
+
+		var names strings.Builder
+		for _, name := range m.stagedConflicts {
+			names.WriteString(fmt.Sprintf("  %s\n", name))
+		}
+
+		content := fmt.Sprintf(
+			"%s\n\n"+
+				"%s\n"+
+				"%s\n\n"+
+				"%s",
+			loc.GitWarnTitle,
+			names.String(),
+			loc.GitWarnPrompt,
+			loc.YesNoKeys,
+		)
+
+		return lipgloss.Place(
+			m.list.Width(),
+			m.list.Height(),
+			lipgloss.Center,
+			lipgloss.Center,
+			warnStyle.Render(content),
+		)
+	}
+
+	if m.mode == bulkConfirmMode {
+		previewStyle := lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(lipgloss.Color("62")).
+			Padding(1, 2).
+			Width(70)
+
+		var lines strings.Builder
+		lines.WriteString(loc.BulkPreview + "\n\n")
+		for _, pair := range m.bulkPreview {
+			lines.WriteString(fmt.Sprintf("  %s → %s\n",
+				filepath.Base(pair.oldPath), filepath.Base(pair.newPath)))
+		}
+		lines.WriteString("\n" + loc.BulkPreviewKeys)
+
+		return lipgloss.Place(
+			m.list.Width(),
+			m.list.Height(),
+			lipgloss.Center,
+			lipgloss.Center,
+			previewStyle.Render(lines.String()),
+		)
+	}
+
 	view := m.list.View()
 
+	if m.mode == bulkRenameMode {
+		helpStyle := lipgloss.NewStyle().
+			Foreground(lipgloss.Color("141")).
+			Padding(0, 1)
+		view += "\n" + helpStyle.Render(loc.BulkHelpKeys)
+	}
+
 	if m.statusMessage != "" {
 		statusStyle := lipgloss.NewStyle().
 			Foreground(lipgloss.Color("170")).
@@ -520,9 +1926,54 @@ func (m model) View() string {
 }
 
 func main() {
+	clearCache := flag.Bool("clear-cache", false, "clear the on-disk translation cache before starting")
+	fromFlag := flag.String("from", "", "source language code (overrides config file)")
+	toFlag := flag.String("to", "", "target language code (overrides config file)")
+	concurrency := flag.Int("concurrency", 4, "number of concurrent translation requests")
+	cacheMaxAge := flag.Duration("cache-max-age", 30*24*time.Hour, "prune cached translations older than this on startup")
+	noGitFlag := flag.Bool("no-git", false, "use plain os.Rename even inside a Git working tree")
+	flag.Parse()
+	noGit = *noGitFlag
+
+	cfg, err := loadConfig(defaultConfigPath())
+	if err != nil {
+		fmt.Printf("Erreur lors du chargement de la configuration: %v\n", err)
+	}
+	if *fromFlag != "" {
+		cfg.From = *fromFlag
+	}
+	if *toFlag != "" {
+		cfg.To = *toFlag
+	}
+	fromLang = cfg.From
+	toLang = cfg.To
+	translator = buildTranslator(cfg)
+
+	cachePath := defaultCachePath()
+
+	if *clearCache {
+		if err := os.Remove(cachePath); err != nil && !os.IsNotExist(err) {
+			fmt.Printf("Erreur lors du vidage du cache: %v\n", err)
+		}
+	}
+
+	if err := cache.Load(cachePath); err != nil {
+		fmt.Printf("Erreur lors du chargement du cache: %v\n", err)
+	}
+	cache.path = cachePath
+	cache.Prune(*cacheMaxAge)
+
+	startTranslationWorkers(*concurrency)
+
 	p := tea.NewProgram(initialModel(), tea.WithAltScreen())
-	if _, err := p.Run(); err != nil {
-		fmt.Printf("Erreur: %v\n", err)
+	_, runErr := p.Run()
+
+	if err := cache.Save(cachePath); err != nil {
+		fmt.Printf("Erreur lors de l'enregistrement du cache: %v\n", err)
+	}
+
+	if runErr != nil {
+		fmt.Printf("Erreur: %v\n", runErr)
 		os.Exit(1)
 	}
 }